@@ -0,0 +1,53 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceAwsAcmpcaPolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsAcmpcaPolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func dataSourceAwsAcmpcaPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	resourceArn := d.Get("resource_arn").(string)
+
+	input := &acmpca.GetPolicyInput{
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Policy: %s", input)
+
+	output, err := conn.GetPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Policy: %s", err)
+	}
+
+	if output == nil || output.Policy == nil {
+		return fmt.Errorf("ACMPCA Policy for resource %q not found", resourceArn)
+	}
+
+	d.SetId(resourceArn)
+	d.Set("policy", output.Policy)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsAcmpcaAcmeResponder stages the token an ACME server's HTTP-01
+// challenge expects to find at
+// http://<domain>/.well-known/acme-challenge/<token>, by writing it to an
+// S3-hosted object, so an aws_acmpca_private_certificate ACME-style issuance
+// can be driven end-to-end from Terraform.
+func resourceAwsAcmpcaAcmeResponder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaAcmeResponderCreate,
+		Read:   resourceAwsAcmpcaAcmeResponderRead,
+		Delete: resourceAwsAcmpcaAcmeResponderDelete,
+
+		Schema: map[string]*schema.Schema{
+			"http_01_challenge": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_authorization": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"s3_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"token": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaAcmeResponderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	challenge := d.Get("http_01_challenge").([]interface{})[0].(map[string]interface{})
+	bucket := challenge["s3_bucket"].(string)
+	token := challenge["token"].(string)
+	key := acmpcaAcmeChallengeS3Key(token)
+
+	input := &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        strings.NewReader(challenge["key_authorization"].(string)),
+		ContentType: aws.String("text/plain"),
+	}
+
+	log.Printf("[DEBUG] Staging ACME HTTP-01 challenge response: %s", input)
+	_, err := conn.PutObject(input)
+	if err != nil {
+		return fmt.Errorf("error staging ACME HTTP-01 challenge response in S3 bucket %q: %s", bucket, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", bucket, key))
+
+	return resourceAwsAcmpcaAcmeResponderRead(d, meta)
+}
+
+func resourceAwsAcmpcaAcmeResponderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	challenge := d.Get("http_01_challenge").([]interface{})[0].(map[string]interface{})
+	bucket := challenge["s3_bucket"].(string)
+	token := challenge["token"].(string)
+	key := acmpcaAcmeChallengeS3Key(token)
+
+	_, err := conn.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchKey, "") || isAWSErr(err, "NotFound", "") {
+			log.Printf("[WARN] ACME HTTP-01 challenge response %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACME HTTP-01 challenge response: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAcmpcaAcmeResponderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).s3conn
+
+	challenge := d.Get("http_01_challenge").([]interface{})[0].(map[string]interface{})
+	bucket := challenge["s3_bucket"].(string)
+	token := challenge["token"].(string)
+	key := acmpcaAcmeChallengeS3Key(token)
+
+	log.Printf("[DEBUG] Removing ACME HTTP-01 challenge response from S3 bucket %q", bucket)
+	_, err := conn.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("error removing ACME HTTP-01 challenge response from S3 bucket %q: %s", bucket, err)
+	}
+
+	return nil
+}
+
+func acmpcaAcmeChallengeS3Key(token string) string {
+	return fmt.Sprintf(".well-known/acme-challenge/%s", token)
+}
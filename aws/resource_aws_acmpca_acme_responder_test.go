@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAwsAcmpcaAcmeResponder_Basic(t *testing.T) {
+	resourceName := "aws_acmpca_acme_responder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsAcmpcaAcmeResponderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaAcmeResponderConfig_Basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaAcmeResponderExists(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaAcmeResponderExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		bucket := rs.Primary.Attributes["http_01_challenge.0.s3_bucket"]
+		token := rs.Primary.Attributes["http_01_challenge.0.token"]
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(acmpcaAcmeChallengeS3Key(token)),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckAwsAcmpcaAcmeResponderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acmpca_acme_responder" {
+			continue
+		}
+
+		bucket := rs.Primary.Attributes["http_01_challenge.0.s3_bucket"]
+		token := rs.Primary.Attributes["http_01_challenge.0.token"]
+
+		_, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(acmpcaAcmeChallengeS3Key(token)),
+		})
+		if err != nil {
+			if isAWSErr(err, s3.ErrCodeNoSuchKey, "") || isAWSErr(err, "NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		return fmt.Errorf("ACME HTTP-01 challenge response %q still exists in S3 bucket %q", token, bucket)
+	}
+
+	return nil
+}
+
+const testAccAwsAcmpcaAcmeResponderConfig_Basic = `
+resource "aws_s3_bucket" "challenge" {
+  bucket        = "tf-acc-test-acmpca-acme-challenge"
+  force_destroy = true
+}
+
+resource "aws_acmpca_acme_responder" "test" {
+  http_01_challenge {
+    s3_bucket         = aws_s3_bucket.challenge.id
+    token             = "test-token"
+    key_authorization = "test-token.test-key-thumbprint"
+  }
+}
+`
@@ -0,0 +1,446 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsAcmpcaCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateRead,
+		Delete: resourceAwsAcmpcaCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// https://docs.aws.amazon.com/acm-pca/latest/APIReference/API_ApiPassthrough.html
+			"api_passthrough": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extensions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_extensions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"critical": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													ForceNew: true,
+												},
+												"object_identifier": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"extended_key_usage": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"extended_key_usage_object_identifier": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"extended_key_usage_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"key_usage": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"crl_sign":          {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"data_encipherment": {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"decipher_only":     {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"digital_signature": {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"encipher_only":     {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"key_agreement":     {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"key_cert_sign":     {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"key_encipherment":  {Type: schema.TypeBool, Optional: true, ForceNew: true},
+												"non_repudiation":   {Type: schema.TypeBool, Optional: true, ForceNew: true},
+											},
+										},
+									},
+									"subject_alternative_names": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"directory_name":              {Type: schema.TypeList, Optional: true, ForceNew: true, MaxItems: 1, Elem: &schema.Resource{Schema: acmpcaASN1SubjectSchema()}},
+												"dns_name":                    {Type: schema.TypeString, Optional: true, ForceNew: true},
+												"ip_address":                  {Type: schema.TypeString, Optional: true, ForceNew: true},
+												"registered_id":               {Type: schema.TypeString, Optional: true, ForceNew: true},
+												"rfc822_name":                 {Type: schema.TypeString, Optional: true, ForceNew: true},
+												"uniform_resource_identifier": {Type: schema.TypeString, Optional: true, ForceNew: true},
+											},
+										},
+									},
+								},
+							},
+						},
+						"subject": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: acmpcaASN1SubjectSchema(),
+							},
+						},
+					},
+				},
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_signing_request": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revocation_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  acmpca.RevocationReasonUnspecified,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.RevocationReasonUnspecified,
+					acmpca.RevocationReasonKeyCompromise,
+					acmpca.RevocationReasonCertificateAuthorityCompromise,
+					acmpca.RevocationReasonAffiliationChanged,
+					acmpca.RevocationReasonSuperseded,
+					acmpca.RevocationReasonCessationOfOperation,
+					acmpca.RevocationReasonPrivilegeWithdrawn,
+					acmpca.RevocationReasonAACompromise,
+				}, false),
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.SigningAlgorithmSha256withecdsa,
+					acmpca.SigningAlgorithmSha256withrsa,
+					acmpca.SigningAlgorithmSha384withecdsa,
+					acmpca.SigningAlgorithmSha384withrsa,
+					acmpca.SigningAlgorithmSha512withecdsa,
+					acmpca.SigningAlgorithmSha512withrsa,
+				}, false),
+			},
+			"template_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "arn:aws:acm-pca:::template/EndEntityCertificate/V1",
+				ValidateFunc: validateArn,
+			},
+			"validity": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								acmpca.ValidityPeriodTypeAbsolute,
+								acmpca.ValidityPeriodTypeDays,
+								acmpca.ValidityPeriodTypeEndDate,
+								acmpca.ValidityPeriodTypeMonths,
+								acmpca.ValidityPeriodTypeYears,
+							}, false),
+						},
+						"value": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// acmpcaASN1SubjectSchema mirrors the ASN1Subject schema used by
+// resourceAwsAcmpcaCertificateAuthority so api_passthrough.subject and
+// subject_alternative_names.directory_name stay consistent with the CA's
+// own subject schema.
+func acmpcaASN1SubjectSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"common_name":                  {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 64)},
+		"country":                      {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 2)},
+		"distinguished_name_qualifier": {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 64)},
+		"generation_qualifier":         {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 3)},
+		"given_name":                   {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 16)},
+		"initials":                     {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 5)},
+		"locality":                     {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 128)},
+		"organization":                 {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 64)},
+		"organizational_unit":          {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 64)},
+		"pseudonym":                    {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 128)},
+		"state":                        {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 128)},
+		"surname":                      {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 40)},
+		"title":                        {Type: schema.TypeString, Optional: true, ForceNew: true, ValidateFunc: validation.StringLenBetween(0, 64)},
+	}
+}
+
+func resourceAwsAcmpcaCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	validity := d.Get("validity").([]interface{})[0].(map[string]interface{})
+
+	input := &acmpca.IssueCertificateInput{
+		ApiPassthrough:          expandAcmpcaApiPassthrough(d.Get("api_passthrough").([]interface{})),
+		CertificateAuthorityArn: aws.String(caARN),
+		Csr:                     []byte(d.Get("certificate_signing_request").(string)),
+		IdempotencyToken:        aws.String(resource.UniqueId()),
+		SigningAlgorithm:        aws.String(d.Get("signing_algorithm").(string)),
+		TemplateArn:             aws.String(d.Get("template_arn").(string)),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(validity["type"].(string)),
+			Value: aws.Int64(int64(validity["value"].(int))),
+		},
+	}
+
+	log.Printf("[DEBUG] Issuing ACMPCA Certificate: %s", input)
+	output, err := conn.IssueCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error issuing ACMPCA Certificate: %s", err)
+	}
+
+	certificateArn := aws.StringValue(output.CertificateArn)
+
+	getCertificateInput := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(certificateArn),
+		CertificateAuthorityArn: aws.String(caARN),
+	}
+
+	log.Printf("[DEBUG] Waiting for ACMPCA Certificate %q to be issued", certificateArn)
+	if err := conn.WaitUntilCertificateIssued(getCertificateInput); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate %q to be issued: %s", certificateArn, err)
+	}
+
+	d.SetId(certificateArn)
+
+	return resourceAwsAcmpcaCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate: %s", input)
+
+	output, err := conn.GetCertificate(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACMPCA Certificate %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Certificate: %s", err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		CertificateSerial:       aws.String(acmpcaCertificateSerialFromArn(d.Id())),
+		RevocationReason:        aws.String(d.Get("revocation_reason").(string)),
+	}
+
+	log.Printf("[DEBUG] Revoking ACMPCA Certificate: %s", input)
+	_, err := conn.RevokeCertificate(input)
+	if err != nil {
+		// ResourceNotFoundException: the CA is already gone.
+		// RequestAlreadyProcessedException: the certificate was already revoked.
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") || isAWSErr(err, acmpca.ErrCodeRequestAlreadyProcessedException, "") {
+			return nil
+		}
+		return fmt.Errorf("error revoking ACMPCA Certificate: %s", err)
+	}
+
+	return nil
+}
+
+func expandAcmpcaApiPassthrough(l []interface{}) *acmpca.ApiPassthrough {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	return &acmpca.ApiPassthrough{
+		Extensions: expandAcmpcaExtensions(m["extensions"].([]interface{})),
+		Subject:    expandAcmpcaASN1Subject(m["subject"].([]interface{})),
+	}
+}
+
+func expandAcmpcaExtensions(l []interface{}) *acmpca.Extensions {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	extensions := &acmpca.Extensions{
+		CustomExtensions:        expandAcmpcaCustomExtensions(m["custom_extensions"].(*schema.Set).List()),
+		ExtendedKeyUsage:        expandAcmpcaExtendedKeyUsages(m["extended_key_usage"].(*schema.Set).List()),
+		SubjectAlternativeNames: expandAcmpcaGeneralNames(m["subject_alternative_names"].(*schema.Set).List()),
+	}
+
+	if keyUsage := m["key_usage"].([]interface{}); len(keyUsage) > 0 && keyUsage[0] != nil {
+		extensions.KeyUsage = expandAcmpcaKeyUsage(keyUsage[0].(map[string]interface{}))
+	}
+
+	return extensions
+}
+
+func expandAcmpcaKeyUsage(m map[string]interface{}) *acmpca.KeyUsage {
+	return &acmpca.KeyUsage{
+		CRLSign:          aws.Bool(m["crl_sign"].(bool)),
+		DataEncipherment: aws.Bool(m["data_encipherment"].(bool)),
+		DecipherOnly:     aws.Bool(m["decipher_only"].(bool)),
+		DigitalSignature: aws.Bool(m["digital_signature"].(bool)),
+		EncipherOnly:     aws.Bool(m["encipher_only"].(bool)),
+		KeyAgreement:     aws.Bool(m["key_agreement"].(bool)),
+		KeyCertSign:      aws.Bool(m["key_cert_sign"].(bool)),
+		KeyEncipherment:  aws.Bool(m["key_encipherment"].(bool)),
+		NonRepudiation:   aws.Bool(m["non_repudiation"].(bool)),
+	}
+}
+
+func expandAcmpcaExtendedKeyUsages(l []interface{}) []*acmpca.ExtendedKeyUsage {
+	extendedKeyUsages := make([]*acmpca.ExtendedKeyUsage, 0, len(l))
+
+	for _, item := range l {
+		m := item.(map[string]interface{})
+
+		eku := &acmpca.ExtendedKeyUsage{}
+		if v, ok := m["extended_key_usage_object_identifier"]; ok && v.(string) != "" {
+			eku.ExtendedKeyUsageObjectIdentifier = aws.String(v.(string))
+		}
+		if v, ok := m["extended_key_usage_type"]; ok && v.(string) != "" {
+			eku.ExtendedKeyUsageType = aws.String(v.(string))
+		}
+
+		extendedKeyUsages = append(extendedKeyUsages, eku)
+	}
+
+	return extendedKeyUsages
+}
+
+func expandAcmpcaCustomExtensions(l []interface{}) []*acmpca.CustomExtension {
+	customExtensions := make([]*acmpca.CustomExtension, 0, len(l))
+
+	for _, item := range l {
+		m := item.(map[string]interface{})
+
+		customExtensions = append(customExtensions, &acmpca.CustomExtension{
+			Critical:         aws.Bool(m["critical"].(bool)),
+			ObjectIdentifier: aws.String(m["object_identifier"].(string)),
+			Value:            aws.String(m["value"].(string)),
+		})
+	}
+
+	return customExtensions
+}
+
+func expandAcmpcaGeneralNames(l []interface{}) []*acmpca.GeneralName {
+	generalNames := make([]*acmpca.GeneralName, 0, len(l))
+
+	for _, item := range l {
+		m := item.(map[string]interface{})
+
+		generalName := &acmpca.GeneralName{}
+		if v, ok := m["dns_name"]; ok && v.(string) != "" {
+			generalName.DnsName = aws.String(v.(string))
+		}
+		if v, ok := m["ip_address"]; ok && v.(string) != "" {
+			generalName.IpAddress = aws.String(v.(string))
+		}
+		if v, ok := m["rfc822_name"]; ok && v.(string) != "" {
+			generalName.Rfc822Name = aws.String(v.(string))
+		}
+		if v, ok := m["registered_id"]; ok && v.(string) != "" {
+			generalName.RegisteredId = aws.String(v.(string))
+		}
+		if v, ok := m["uniform_resource_identifier"]; ok && v.(string) != "" {
+			generalName.UniformResourceIdentifier = aws.String(v.(string))
+		}
+		if v, ok := m["directory_name"]; ok && len(v.([]interface{})) > 0 {
+			generalName.DirectoryName = expandAcmpcaASN1Subject(v.([]interface{}))
+		}
+
+		generalNames = append(generalNames, generalName)
+	}
+
+	return generalNames
+}
@@ -28,6 +28,7 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 		MigrateState:  resourceAwsAcmpcaCertificateAuthorityMigrateState,
 		SchemaVersion: 1,
@@ -232,6 +233,41 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 										Optional:     true,
 										ValidateFunc: validation.StringLenBetween(0, 255),
 									},
+									// Required when the CRL S3 bucket has Block Public Access
+									// enabled; AWS otherwise defaults to PUBLIC_READ.
+									"s3_object_acl": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											acmpca.S3ObjectAclPublicRead,
+											acmpca.S3ObjectAclBucketOwnerFullControl,
+										}, false),
+									},
+								},
+							},
+						},
+						// https://docs.aws.amazon.com/acm-pca/latest/APIReference/API_OcspConfiguration.html
+						"ocsp_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								if old == "1" && new == "0" {
+									return true
+								}
+								return false
+							},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"ocsp_custom_cname": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 253),
+									},
 								},
 							},
 						},
@@ -252,6 +288,23 @@ func resourceAwsAcmpcaCertificateAuthority() *schema.Resource {
 				Default:      30,
 				ValidateFunc: validation.IntBetween(7, 30),
 			},
+			// renewal_trigger is never sent to AWS; changing its value is
+			// purely a signal to Update to re-issue and re-import the CA's
+			// own certificate without destroying the CA (which ForceNew on
+			// validity_length/validity_unit would otherwise require).
+			"renewal_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// renewal_certificate_authority_arn points at the CA that should
+			// sign the renewal CSR. Left unset, a ROOT CA is renewed with a
+			// self-signed RootCACertificate; a SUBORDINATE CA must set this
+			// to its parent so the new certificate is signed externally.
+			"renewal_certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateArn,
+			},
 			"tags": tagsSchema(),
 			"type": {
 				Type:     schema.TypeString,
@@ -506,9 +559,106 @@ func resourceAwsAcmpcaCertificateAuthorityUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("renewal_trigger") {
+		if err := acmpcaCertificateAuthorityRenew(conn, d); err != nil {
+			return fmt.Errorf("error renewing ACMPCA Certificate Authority %q: %s", d.Id(), err)
+		}
+	}
+
 	return resourceAwsAcmpcaCertificateAuthorityRead(d, meta)
 }
 
+// acmpcaCertificateAuthorityRenew re-issues and re-imports a CA's own
+// certificate in place, without destroying the CertificateAuthorityArn. A
+// ROOT CA self-signs the new certificate; a SUBORDINATE CA has its CSR
+// signed by renewal_certificate_authority_arn.
+func acmpcaCertificateAuthorityRenew(conn *acmpca.ACMPCA, d *schema.ResourceData) error {
+	getCertificateAuthorityCsrInput := &acmpca.GetCertificateAuthorityCsrInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority Certificate Signing Request for renewal: %s", getCertificateAuthorityCsrInput)
+
+	getCertificateAuthorityCsrOutput, err := conn.GetCertificateAuthorityCsr(getCertificateAuthorityCsrInput)
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate Authority Certificate Signing Request: %s", err)
+	}
+
+	certificateAuthorityConfiguration := d.Get("certificate_authority_configuration").([]interface{})[0].(map[string]interface{})
+
+	issuingCertificateAuthorityArn := d.Id()
+	templateArn := "arn:aws:acm-pca:::template/RootCACertificate/V1"
+	if v, ok := d.GetOk("renewal_certificate_authority_arn"); ok {
+		issuingCertificateAuthorityArn = v.(string)
+		templateArn = "arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"
+	}
+
+	issueCertificateInput := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(issuingCertificateAuthorityArn),
+		Csr:                     getCertificateAuthorityCsrOutput.Csr,
+		IdempotencyToken:        aws.String(resource.UniqueId()),
+		SigningAlgorithm:        aws.String(certificateAuthorityConfiguration["signing_algorithm"].(string)),
+		TemplateArn:             aws.String(templateArn),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(d.Get("validity_unit").(string)),
+			Value: aws.Int64(int64(d.Get("validity_length").(int))),
+		},
+	}
+
+	log.Printf("[DEBUG] ACMPCA Issue renewed Certificate: %s", issueCertificateInput)
+
+	issueCertificateOutput, err := conn.IssueCertificate(issueCertificateInput)
+	if err != nil {
+		return fmt.Errorf("error issuing renewed ACMPCA Certificate: %s", err)
+	}
+
+	certificateArn := aws.StringValue(issueCertificateOutput.CertificateArn)
+
+	getCertificateInput := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(certificateArn),
+		CertificateAuthorityArn: aws.String(issuingCertificateAuthorityArn),
+	}
+
+	if err := conn.WaitUntilCertificateIssued(getCertificateInput); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA to issue renewed Certificate %q: %s", certificateArn, err)
+	}
+
+	getCertificateOutput, err := conn.GetCertificate(getCertificateInput)
+	if err != nil {
+		return fmt.Errorf("error retrieving renewed ACMPCA Certificate %q: %s", certificateArn, err)
+	}
+
+	importCertificateAuthorityCertificateInput := &acmpca.ImportCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+		Certificate:             []byte(aws.StringValue(getCertificateOutput.Certificate)),
+		CertificateChain:        []byte(aws.StringValue(getCertificateOutput.CertificateChain)),
+	}
+
+	log.Printf("[DEBUG] ACMPCA import renewed Certificate Authority Certificate: %s", importCertificateAuthorityCertificateInput)
+
+	_, err = conn.ImportCertificateAuthorityCertificate(importCertificateAuthorityCertificateInput)
+	if err != nil {
+		return fmt.Errorf("error importing renewed ACMPCA Certificate Authority Certificate %q in ACMPCA Certificate Authority %q: %s", certificateArn, d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			acmpca.CertificateAuthorityStatusPendingCertificate,
+		},
+		Target: []string{
+			acmpca.CertificateAuthorityStatusActive,
+		},
+		Refresh: acmpcaCertificateAuthorityRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutUpdate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate Authority %q to become active after renewal: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceAwsAcmpcaCertificateAuthorityDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).acmpcaconn
 
@@ -715,6 +865,27 @@ func expandAcmpcaCrlConfiguration(l []interface{}) *acmpca.CrlConfiguration {
 	if v, ok := m["s3_bucket_name"]; ok && v.(string) != "" {
 		config.S3BucketName = aws.String(v.(string))
 	}
+	if v, ok := m["s3_object_acl"]; ok && v.(string) != "" {
+		config.S3ObjectAcl = aws.String(v.(string))
+	}
+
+	return config
+}
+
+func expandAcmpcaOcspConfiguration(l []interface{}) *acmpca.OcspConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	config := &acmpca.OcspConfiguration{
+		Enabled: aws.Bool(m["enabled"].(bool)),
+	}
+
+	if v, ok := m["ocsp_custom_cname"]; ok && v.(string) != "" {
+		config.OcspCustomCname = aws.String(v.(string))
+	}
 
 	return config
 }
@@ -727,7 +898,8 @@ func expandAcmpcaRevocationConfiguration(l []interface{}) *acmpca.RevocationConf
 	m := l[0].(map[string]interface{})
 
 	config := &acmpca.RevocationConfiguration{
-		CrlConfiguration: expandAcmpcaCrlConfiguration(m["crl_configuration"].([]interface{})),
+		CrlConfiguration:  expandAcmpcaCrlConfiguration(m["crl_configuration"].([]interface{})),
+		OcspConfiguration: expandAcmpcaOcspConfiguration(m["ocsp_configuration"].([]interface{})),
 	}
 
 	return config
@@ -781,6 +953,20 @@ func flattenAcmpcaCrlConfiguration(config *acmpca.CrlConfiguration) []interface{
 		"enabled":            aws.BoolValue(config.Enabled),
 		"expiration_in_days": int(aws.Int64Value(config.ExpirationInDays)),
 		"s3_bucket_name":     aws.StringValue(config.S3BucketName),
+		"s3_object_acl":      aws.StringValue(config.S3ObjectAcl),
+	}
+
+	return []interface{}{m}
+}
+
+func flattenAcmpcaOcspConfiguration(config *acmpca.OcspConfiguration) []interface{} {
+	if config == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"enabled":           aws.BoolValue(config.Enabled),
+		"ocsp_custom_cname": aws.StringValue(config.OcspCustomCname),
 	}
 
 	return []interface{}{m}
@@ -792,7 +978,8 @@ func flattenAcmpcaRevocationConfiguration(config *acmpca.RevocationConfiguration
 	}
 
 	m := map[string]interface{}{
-		"crl_configuration": flattenAcmpcaCrlConfiguration(config.CrlConfiguration),
+		"crl_configuration":  flattenAcmpcaCrlConfiguration(config.CrlConfiguration),
+		"ocsp_configuration": flattenAcmpcaOcspConfiguration(config.OcspConfiguration),
 	}
 
 	return []interface{}{m}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourceAwsAcmpcaCertificateAuthorityCertificate installs a signed CA
+// certificate (e.g. one issued by aws_acmpca_certificate with a
+// SubordinateCACertificate template, or by an external root) and waits for
+// the certificate authority to transition out of PENDING_CERTIFICATE.
+func resourceAwsAcmpcaCertificateAuthorityCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateAuthorityCertificateCreate,
+		Read:   resourceAwsAcmpcaCertificateAuthorityCertificateRead,
+		Update: resourceAwsAcmpcaCertificateAuthorityCertificateUpdate,
+		Delete: resourceAwsAcmpcaCertificateAuthorityCertificateDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeCert,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				StateFunc: normalizeCert,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	if err := acmpcaImportCertificateAuthorityCertificate(d, conn, caARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(caARN)
+
+	return resourceAwsAcmpcaCertificateAuthorityCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	if err := acmpcaImportCertificateAuthorityCertificate(d, conn, caARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAwsAcmpcaCertificateAuthorityCertificateRead(d, meta)
+}
+
+func acmpcaImportCertificateAuthorityCertificate(d *schema.ResourceData, conn *acmpca.ACMPCA, caARN string, timeout time.Duration) error {
+	input := &acmpca.ImportCertificateAuthorityCertificateInput{
+		Certificate:             []byte(d.Get("certificate").(string)),
+		CertificateAuthorityArn: aws.String(caARN),
+	}
+
+	if chain, ok := d.GetOk("certificate_chain"); ok {
+		input.CertificateChain = []byte(chain.(string))
+	}
+
+	log.Printf("[DEBUG] Importing ACMPCA Certificate Authority Certificate: %s", input)
+	_, err := conn.ImportCertificateAuthorityCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error importing ACMPCA Certificate Authority Certificate: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			acmpca.CertificateAuthorityStatusPendingCertificate,
+		},
+		Target: []string{
+			acmpca.CertificateAuthorityStatusActive,
+		},
+		Refresh: acmpcaCertificateAuthorityRefreshFunc(conn, caARN),
+		Timeout: timeout,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate Authority %q to become active after importing certificate: %s", caARN, err)
+	}
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	input := &acmpca.GetCertificateAuthorityCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority Certificate: %s", input)
+
+	output, err := conn.GetCertificateAuthorityCertificate(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACMPCA Certificate Authority %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		// Returned when in PENDING_CERTIFICATE status
+		if isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+			log.Printf("[WARN] ACMPCA Certificate Authority %q is PENDING_CERTIFICATE - removing from state", caARN)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("error reading ACMPCA Certificate Authority Certificate from ACMPCA Certificate Authority %q: %s", caARN, err)
+	}
+
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	caARN := d.Get("certificate_authority_arn").(string)
+	// ACM PCA has no API to un-install a CA certificate once imported, so
+	// there is nothing to do here beyond dropping the resource from state.
+	log.Printf("[WARN] Certificate Authority Certificate can never be detached from an ACMPCA Certificate Authority %q, only overwritten", caARN)
+	return nil
+}
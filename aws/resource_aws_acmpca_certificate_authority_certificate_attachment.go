@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -17,7 +18,15 @@ func resourceAwsAcmpcaCertificateAuthorityCertificateAttachment() *schema.Resour
 		Delete:        resourceAwsAcmpcaCertificateAuthorityCertificateAttachmentDelete,
 		SchemaVersion: 1,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"certificate_authority_arn": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -60,6 +69,22 @@ func resourceAwsAcmpcaCertificateAuthorityCertificateAttachmentCreate(d *schema.
 	}
 
 	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", caARN)))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			acmpca.CertificateAuthorityStatusPendingCertificate,
+		},
+		Target: []string{
+			acmpca.CertificateAuthorityStatusActive,
+		},
+		Refresh: acmpcaCertificateAuthorityRefreshFunc(conn, caARN),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate Authority %q to become active after importing certificate: %s", caARN, err)
+	}
+
 	return resourceAwsAcmpcaCertificateAuthorityCertificateAttachmentRead(d, meta)
 }
 
@@ -90,9 +115,22 @@ func resourceAwsAcmpcaCertificateAuthorityCertificateAttachmentRead(d *schema.Re
 		return fmt.Errorf("error reading ACMPCA Certificate Authority Certificate from ACMPCA Certificate Authority %q: %s", caARN, err)
 	}
 
-	d.Set("certificate", getCertificateAuthorityCertificateOutput.Certificate)
+	liveCertificate := aws.StringValue(getCertificateAuthorityCertificateOutput.Certificate)
+
+	d.Set("certificate", liveCertificate)
 	d.Set("certificate_chain", getCertificateAuthorityCertificateOutput.CertificateChain)
 
+	// The CA certificate can be re-imported out-of-band at any time, which
+	// silently changes what's active on the CA. Compare fingerprints so an
+	// out-of-band re-import surfaces as a diff on certificate_body instead
+	// of being ignored. certificate_body is held in state as its normalizeCert
+	// fingerprint, but is still the raw PEM when Read runs from Create.
+	storedCertificateBody := d.Get("certificate_body").(string)
+	liveFingerprint := normalizeCert(liveCertificate)
+	if storedCertificateBody != liveFingerprint && normalizeCert(storedCertificateBody) != liveFingerprint {
+		d.Set("certificate_body", liveFingerprint)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_RootSelfSigned(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootSelfSigned,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAwsAcmpcaCertificateAuthorityCertificate_Subordinate imports a
+// certificate issued by a separate root CA into a subordinate CA, covering
+// the subordinate-signing scenario alongside
+// TestAccAwsAcmpcaCertificateAuthorityCertificate_RootSelfSigned's
+// root-self-signing scenario.
+func TestAccAwsAcmpcaCertificateAuthorityCertificate_Subordinate(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority_certificate.subordinate"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Subordinate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+				),
+			},
+		},
+	})
+}
+
+const testAccAwsAcmpcaCertificateAuthorityCertificateConfig_Subordinate = `
+resource "aws_acmpca_certificate_authority" "root" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "root" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.root.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.root.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+  template_arn                = "arn:aws:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "root" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.root.arn
+  certificate               = aws_acmpca_certificate.root.certificate
+  certificate_chain         = aws_acmpca_certificate.root.certificate_chain
+}
+
+resource "aws_acmpca_certificate_authority" "subordinate" {
+  permanent_deletion_time_in_days = 7
+  type                             = "SUBORDINATE"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "subordinate.terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "subordinate" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.root.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.subordinate.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+  template_arn                = "arn:aws:acm-pca:::template/SubordinateCACertificate_PathLen0/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.root]
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "subordinate" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.subordinate.arn
+  certificate               = aws_acmpca_certificate.subordinate.certificate
+  certificate_chain         = aws_acmpca_certificate.subordinate.certificate_chain
+}
+`
+
+const testAccAwsAcmpcaCertificateAuthorityCertificateConfig_RootSelfSigned = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = aws_acmpca_certificate_authority.test.certificate_signing_request
+  signing_algorithm           = "SHA512WITHRSA"
+  template_arn                = "arn:aws:acm-pca:::template/RootCACertificate/V1"
+
+  validity {
+    type  = "YEARS"
+    value = 1
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate.test.certificate
+  certificate_chain         = aws_acmpca_certificate.test.certificate_chain
+}
+`
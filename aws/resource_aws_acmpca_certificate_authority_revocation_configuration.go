@@ -0,0 +1,291 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsAcmpcaCertificateAuthorityRevocationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationPut,
+		Read:   resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationRead,
+		Update: resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationPut,
+		Delete: resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"revocation_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crl_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"custom_cname": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 253),
+									},
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 5000),
+									},
+									"s3_bucket_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 255),
+									},
+									"s3_object_acl": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											acmpca.S3ObjectAclPublicRead,
+											acmpca.S3ObjectAclBucketOwnerFullControl,
+										}, false),
+									},
+								},
+							},
+						},
+						"ocsp_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"ocsp_custom_cname": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 253),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	describeCertificateAuthorityOutput, err := conn.DescribeCertificateAuthority(&acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(caARN),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading ACMPCA Certificate Authority %q: %s", caARN, err)
+	}
+
+	if describeCertificateAuthorityOutput.CertificateAuthority != nil &&
+		aws.StringValue(describeCertificateAuthorityOutput.CertificateAuthority.Status) == acmpca.CertificateAuthorityStatusPendingCertificate {
+		return fmt.Errorf("ACMPCA Certificate Authority %q is PENDING_CERTIFICATE and cannot have its revocation configuration updated until it has an active certificate installed", caARN)
+	}
+
+	revocationConfiguration := expandAcmpcaRevocationConfiguration(d.Get("revocation_configuration").([]interface{}))
+
+	if crl := revocationConfiguration.CrlConfiguration; crl != nil && aws.BoolValue(crl.Enabled) {
+		if err := validateAcmpcaCrlS3BucketPolicy(meta.(*AWSClient), aws.StringValue(crl.S3BucketName)); err != nil {
+			return err
+		}
+	}
+
+	input := &acmpca.UpdateCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		RevocationConfiguration: revocationConfiguration,
+	}
+
+	log.Printf("[DEBUG] Updating ACMPCA Certificate Authority Revocation Configuration: %s", input)
+	_, err = conn.UpdateCertificateAuthority(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+			return fmt.Errorf("ACMPCA Certificate Authority %q is not in a state that allows updating its revocation configuration: %s", caARN, err)
+		}
+		return fmt.Errorf("error updating ACMPCA Certificate Authority Revocation Configuration: %s", err)
+	}
+
+	d.SetId(caARN)
+
+	return resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationRead(d, meta)
+}
+
+func resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate Authority: %s", input)
+
+	output, err := conn.DescribeCertificateAuthority(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACMPCA Certificate Authority %q not found - removing revocation configuration from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Certificate Authority: %s", err)
+	}
+
+	if output.CertificateAuthority == nil {
+		log.Printf("[WARN] ACMPCA Certificate Authority %q not found - removing revocation configuration from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate_authority_arn", output.CertificateAuthority.Arn)
+
+	if err := d.Set("revocation_configuration", flattenAcmpcaRevocationConfiguration(output.CertificateAuthority.RevocationConfiguration)); err != nil {
+		return fmt.Errorf("error setting revocation_configuration: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsAcmpcaCertificateAuthorityRevocationConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.UpdateCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(d.Id()),
+		RevocationConfiguration: &acmpca.RevocationConfiguration{
+			CrlConfiguration: &acmpca.CrlConfiguration{
+				Enabled: aws.Bool(false),
+			},
+			OcspConfiguration: &acmpca.OcspConfiguration{
+				Enabled: aws.Bool(false),
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Disabling ACMPCA Certificate Authority Revocation Configuration: %s", input)
+	_, err := conn.UpdateCertificateAuthority(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") || isAWSErr(err, acmpca.ErrCodeInvalidStateException, "") {
+			return nil
+		}
+		return fmt.Errorf("error disabling ACMPCA Certificate Authority Revocation Configuration: %s", err)
+	}
+
+	return nil
+}
+
+// validateAcmpcaCrlS3BucketPolicy checks that the given S3 bucket's policy
+// grants acm-pca.amazonaws.com the permissions it needs to publish a CRL,
+// surfacing a clear error instead of letting AWS fail CRL publication later
+// with a generic AccessDenied.
+func validateAcmpcaCrlS3BucketPolicy(client *AWSClient, bucket string) error {
+	if bucket == "" {
+		return nil
+	}
+
+	output, err := client.s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if isAWSErr(err, s3.ErrCodeNoSuchBucketPolicy, "") {
+			return fmt.Errorf("S3 bucket %q has no bucket policy granting acm-pca.amazonaws.com GetBucketAcl/PutObject; CRL publication will fail with AccessDenied", bucket)
+		}
+		return fmt.Errorf("error reading policy for S3 bucket %q: %s", bucket, err)
+	}
+
+	var policy struct {
+		Statement []struct {
+			Principal interface{} `json:"Principal"`
+			Action    interface{} `json:"Action"`
+		} `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(aws.StringValue(output.Policy)), &policy); err != nil {
+		return fmt.Errorf("error parsing policy for S3 bucket %q: %s", bucket, err)
+	}
+
+	var grantsAcl, grantsPutObject bool
+	for _, statement := range policy.Statement {
+		if !acmpcaPolicyPrincipalIncludes(statement.Principal, "acm-pca.amazonaws.com") {
+			continue
+		}
+		if acmpcaPolicyActionIncludes(statement.Action, "s3:GetBucketAcl") {
+			grantsAcl = true
+		}
+		if acmpcaPolicyActionIncludes(statement.Action, "s3:PutObject") {
+			grantsPutObject = true
+		}
+	}
+
+	if !grantsAcl || !grantsPutObject {
+		return fmt.Errorf("S3 bucket %q policy must grant acm-pca.amazonaws.com both s3:GetBucketAcl and s3:PutObject for CRL publication", bucket)
+	}
+
+	return nil
+}
+
+func acmpcaPolicyPrincipalIncludes(principal interface{}, service string) bool {
+	switch v := principal.(type) {
+	case string:
+		return v == "*" || strings.Contains(v, service)
+	case map[string]interface{}, []interface{}:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return false
+		}
+		return strings.Contains(string(b), service)
+	default:
+		return false
+	}
+}
+
+func acmpcaPolicyActionIncludes(action interface{}, wanted string) bool {
+	switch v := action.(type) {
+	case string:
+		return acmpcaPolicyActionMatches(v, wanted)
+	case []interface{}:
+		for _, a := range v {
+			if s, ok := a.(string); ok && acmpcaPolicyActionMatches(s, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// acmpcaPolicyActionMatches reports whether a policy statement's action
+// grants wanted, treating "*" and service-level wildcards like "s3:*" as
+// matching any action in that service.
+func acmpcaPolicyActionMatches(action, wanted string) bool {
+	if action == "*" {
+		return true
+	}
+	if service := strings.SplitN(wanted, ":", 2)[0]; strings.EqualFold(action, service+":*") {
+		return true
+	}
+	return strings.EqualFold(action, wanted)
+}
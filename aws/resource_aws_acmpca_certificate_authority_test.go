@@ -0,0 +1,246 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAwsAcmpcaCertificateAuthority_RevocationConfiguration_Ocsp(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			// Neither CRL nor OCSP configured.
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_Empty,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmpcaCertificateAuthorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.ocsp_configuration.#", "0"),
+				),
+			},
+			// OCSP only - should update in place, not replace the CA.
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_Ocsp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmpcaCertificateAuthorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.ocsp_configuration.0.enabled", "true"),
+				),
+			},
+			// CRL and OCSP together - still an update, not a replace.
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_CrlAndOcsp,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmpcaCertificateAuthorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.ocsp_configuration.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAwsAcmpcaCertificateAuthority_RevocationConfiguration_S3ObjectAcl
+// covers publishing a CRL to a bucket whose Block Public Access settings
+// reject the default PUBLIC_READ object ACL, requiring
+// s3_object_acl = "BUCKET_OWNER_FULL_CONTROL" for CRL publication to
+// succeed.
+func TestAccAwsAcmpcaCertificateAuthority_RevocationConfiguration_S3ObjectAcl(t *testing.T) {
+	resourceName := "aws_acmpca_certificate_authority.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_S3ObjectAcl,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAcmpcaCertificateAuthorityExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_configuration.0.crl_configuration.0.s3_object_acl", "BUCKET_OWNER_FULL_CONTROL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAcmpcaCertificateAuthorityDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_acmpca_certificate_authority" {
+			continue
+		}
+
+		output, err := conn.DescribeCertificateAuthority(&acmpca.DescribeCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+				continue
+			}
+			return err
+		}
+
+		if output != nil && output.CertificateAuthority != nil && aws.StringValue(output.CertificateAuthority.Status) != acmpca.CertificateAuthorityStatusDeleted {
+			return fmt.Errorf("ACMPCA Certificate Authority %q still exists in non-DELETED state: %s", rs.Primary.ID, aws.StringValue(output.CertificateAuthority.Status))
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAcmpcaCertificateAuthorityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).acmpcaconn
+		_, err := conn.DescribeCertificateAuthority(&acmpca.DescribeCertificateAuthorityInput{
+			CertificateAuthorityArn: aws.String(rs.Primary.ID),
+		})
+
+		return err
+	}
+}
+
+const testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_Empty = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+`
+
+const testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_Ocsp = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    ocsp_configuration {
+      enabled = true
+    }
+  }
+}
+`
+
+const testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_S3ObjectAcl = `
+resource "aws_s3_bucket" "crl" {
+  bucket        = "tf-acc-test-acmpca-crl-object-acl"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_public_access_block" "crl" {
+  bucket = aws_s3_bucket.crl.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+resource "aws_s3_bucket_policy" "crl" {
+  bucket = aws_s3_bucket.crl.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AcmPcaWriteCrl"
+      Effect    = "Allow"
+      Principal = { Service = "acm-pca.amazonaws.com" }
+      Action    = ["s3:GetBucketAcl", "s3:PutObject"]
+      Resource = [
+        aws_s3_bucket.crl.arn,
+        "${aws_s3_bucket.crl.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled             = true
+      expiration_in_days  = 7
+      s3_bucket_name      = aws_s3_bucket.crl.id
+      s3_object_acl       = "BUCKET_OWNER_FULL_CONTROL"
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.crl, aws_s3_bucket_public_access_block.crl]
+}
+`
+
+const testAccAwsAcmpcaCertificateAuthorityConfig_RevocationConfiguration_CrlAndOcsp = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled             = true
+      expiration_in_days  = 7
+    }
+
+    ocsp_configuration {
+      enabled = true
+    }
+  }
+}
+`
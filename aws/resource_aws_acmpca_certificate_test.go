@@ -0,0 +1,308 @@
+package aws
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAwsAcmpcaCertificate_Basic(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateConfig_Basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_chain"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAwsAcmpcaCertificate_RevocationReflectedInCrl issues a certificate
+// against a CA whose revocation_configuration publishes a CRL to S3, then
+// destroys the aws_acmpca_certificate (which revokes it) and checks that the
+// published CRL lists the certificate's serial as revoked.
+func TestAccAwsAcmpcaCertificate_RevocationReflectedInCrl(t *testing.T) {
+	resourceName := "aws_acmpca_certificate.test"
+	bucketResourceName := "aws_s3_bucket.crl"
+	var serial string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAcmpcaCertificateAuthorityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaCertificateConfig_CrlRevocation,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaCertificateSerial(resourceName, &serial),
+				),
+			},
+			{
+				// Removing the certificate revokes it; this step's Check then
+				// asserts the revocation is reflected in the published CRL
+				// while the CRL bucket still exists.
+				Config: testAccAwsAcmpcaCertificateConfig_CrlRevocation_Removed,
+				Check:  testAccCheckAwsAcmpcaCertificateRevokedInCrl(bucketResourceName, &serial),
+			},
+		},
+	})
+}
+
+func testAccCheckAwsAcmpcaCertificateSerial(resourceName string, serial *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		*serial = acmpcaCertificateSerialFromArn(rs.Primary.ID)
+
+		return nil
+	}
+}
+
+// testAccCheckAwsAcmpcaCertificateRevokedInCrl fetches the CRL object
+// published to the given S3 bucket and asserts that it lists serial as a
+// revoked certificate.
+func testAccCheckAwsAcmpcaCertificateRevokedInCrl(bucketResourceName string, serial *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[bucketResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", bucketResourceName)
+		}
+		bucket := rs.Primary.ID
+
+		conn := testAccProvider.Meta().(*AWSClient).s3conn
+
+		listOutput, err := conn.ListObjects(&s3.ListObjectsInput{Bucket: aws.String(bucket)})
+		if err != nil {
+			return fmt.Errorf("error listing objects in S3 bucket %q: %s", bucket, err)
+		}
+		if len(listOutput.Contents) == 0 {
+			return fmt.Errorf("no CRL object published to S3 bucket %q", bucket)
+		}
+
+		getOutput, err := conn.GetObject(&s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    listOutput.Contents[0].Key,
+		})
+		if err != nil {
+			return fmt.Errorf("error reading CRL object from S3 bucket %q: %s", bucket, err)
+		}
+		defer getOutput.Body.Close()
+
+		crlBytes, err := ioutil.ReadAll(getOutput.Body)
+		if err != nil {
+			return fmt.Errorf("error reading CRL object body: %s", err)
+		}
+
+		crl, err := x509.ParseCRL(crlBytes)
+		if err != nil {
+			return fmt.Errorf("error parsing published CRL: %s", err)
+		}
+
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			if revoked.SerialNumber.Text(16) == *serial {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("certificate with serial %q not found in published CRL", *serial)
+	}
+}
+
+const testAccAwsAcmpcaCertificateConfig_Basic = `
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate_authority.test.certificate
+}
+
+resource "tls_private_key" "key" {
+  algorithm = "RSA"
+}
+
+resource "tls_cert_request" "csr" {
+  key_algorithm   = "RSA"
+  private_key_pem = tls_private_key.key.private_key_pem
+
+  subject {
+    common_name = "leaf.terraformtesting.com"
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = tls_cert_request.csr.cert_request_pem
+  signing_algorithm           = "SHA512WITHRSA"
+
+  validity {
+    type  = "DAYS"
+    value = 30
+  }
+}
+`
+
+const testAccAwsAcmpcaCertificateConfig_CrlRevocation = `
+resource "aws_s3_bucket" "crl" {
+  bucket        = "tf-acc-test-acmpca-crl"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "crl" {
+  bucket = aws_s3_bucket.crl.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AcmPcaWriteCrl"
+      Effect    = "Allow"
+      Principal = { Service = "acm-pca.amazonaws.com" }
+      Action    = ["s3:GetBucketAcl", "s3:PutObject"]
+      Resource = [
+        aws_s3_bucket.crl.arn,
+        "${aws_s3_bucket.crl.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled            = true
+      expiration_in_days = 7
+      s3_bucket_name     = aws_s3_bucket.crl.id
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.crl]
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate_authority.test.certificate
+}
+
+resource "tls_private_key" "key" {
+  algorithm = "RSA"
+}
+
+resource "tls_cert_request" "csr" {
+  key_algorithm   = "RSA"
+  private_key_pem = tls_private_key.key.private_key_pem
+
+  subject {
+    common_name = "leaf.terraformtesting.com"
+  }
+}
+
+resource "aws_acmpca_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = tls_cert_request.csr.cert_request_pem
+  signing_algorithm           = "SHA512WITHRSA"
+
+  validity {
+    type  = "DAYS"
+    value = 30
+  }
+}
+`
+
+const testAccAwsAcmpcaCertificateConfig_CrlRevocation_Removed = `
+resource "aws_s3_bucket" "crl" {
+  bucket        = "tf-acc-test-acmpca-crl"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "crl" {
+  bucket = aws_s3_bucket.crl.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AcmPcaWriteCrl"
+      Effect    = "Allow"
+      Principal = { Service = "acm-pca.amazonaws.com" }
+      Action    = ["s3:GetBucketAcl", "s3:PutObject"]
+      Resource = [
+        aws_s3_bucket.crl.arn,
+        "${aws_s3_bucket.crl.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                             = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled            = true
+      expiration_in_days = 7
+      s3_bucket_name     = aws_s3_bucket.crl.id
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.crl]
+}
+
+resource "aws_acmpca_certificate_authority_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  certificate               = aws_acmpca_certificate_authority.test.certificate
+}
+`
@@ -0,0 +1,341 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// resourceAwsAcmpcaLocallySignedCertificate signs a CSR locally with
+// crypto/x509, without calling the ACM PCA API, so a short-lived
+// intermediate issued by aws_acmpca_certificate_authority can mint leaf
+// certificates without per-certificate ACM PCA charges or API limits. Its
+// schema mirrors the community tls provider's tls_locally_signed_cert
+// resource so migrating between the two is a drop-in change.
+func resourceAwsAcmpcaLocallySignedCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaLocallySignedCertificateCreate,
+		Read:   resourceAwsAcmpcaLocallySignedCertificateRead,
+		Delete: resourceAwsAcmpcaLocallySignedCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"allowed_uses": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(acmpcaLocallySignedCertificateAllowedUses(), false),
+				},
+			},
+			"ca_cert_pem": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ca_key_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ca_private_key_pem": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"cert_pem": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cert_request_pem": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dns_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"early_renewal_hours": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			"ip_addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_algorithm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(acmpcaLocallySignedCertificateSigningAlgorithms(), false),
+			},
+			"uris": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"validity_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"validity_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"HOURS",
+					"DAYS",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaLocallySignedCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	csrBlock, _ := pem.Decode([]byte(d.Get("cert_request_pem").(string)))
+	if csrBlock == nil {
+		return fmt.Errorf("could not decode PEM block from cert_request_pem")
+	}
+
+	csr, err := x509.ParseCertificateRequest(csrBlock.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing certificate signing request: %s", err)
+	}
+
+	caCertBlock, _ := pem.Decode([]byte(d.Get("ca_cert_pem").(string)))
+	if caCertBlock == nil {
+		return fmt.Errorf("could not decode PEM block from ca_cert_pem")
+	}
+
+	caCert, err := x509.ParseCertificate(caCertBlock.Bytes)
+	if err != nil {
+		return fmt.Errorf("error parsing ca_cert_pem: %s", err)
+	}
+
+	caKeyBlock, _ := pem.Decode([]byte(d.Get("ca_private_key_pem").(string)))
+	if caKeyBlock == nil {
+		return fmt.Errorf("could not decode PEM block from ca_private_key_pem")
+	}
+
+	caKey, err := parsePrivateKeyPemBlock(caKeyBlock)
+	if err != nil {
+		return err
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return fmt.Errorf("error generating certificate serial number: %s", err)
+	}
+
+	notBefore := time.Now()
+	notAfter, err := acmpcaLocallySignedCertificateNotAfter(notBefore, d.Get("validity_unit").(string), d.Get("validity_length").(int))
+	if err != nil {
+		return err
+	}
+
+	signatureAlgorithm, err := acmpcaExpandSignatureAlgorithm(d.Get("signing_algorithm").(string))
+	if err != nil {
+		return err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               csr.Subject,
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		SignatureAlgorithm:    signatureAlgorithm,
+		BasicConstraintsValid: true,
+		KeyUsage:              acmpcaExpandKeyUsage(d.Get("allowed_uses").([]interface{})),
+		ExtKeyUsage:           acmpcaExpandExtKeyUsages(d.Get("allowed_uses").([]interface{})),
+		DNSNames:              append(csr.DNSNames, expandStringList(d.Get("dns_names").([]interface{}))...),
+		IPAddresses:           append(csr.IPAddresses, acmpcaExpandIPAddresses(d.Get("ip_addresses").([]interface{}))...),
+	}
+
+	for _, rawUri := range d.Get("uris").([]interface{}) {
+		parsed, err := url.Parse(rawUri.(string))
+		if err != nil {
+			return fmt.Errorf("error parsing uri %q: %s", rawUri, err)
+		}
+		template.URIs = append(template.URIs, parsed)
+	}
+
+	certDer, err := x509.CreateCertificate(rand.Reader, template, caCert, csr.PublicKey, caKey)
+	if err != nil {
+		return fmt.Errorf("error signing certificate: %s", err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDer})
+
+	d.SetId(fmt.Sprintf("%x", serialNumber))
+	d.Set("cert_pem", string(certPem))
+	d.Set("not_after", notAfter.Format(time.RFC3339))
+
+	return nil
+}
+
+func resourceAwsAcmpcaLocallySignedCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	notAfter, err := time.Parse(time.RFC3339, d.Get("not_after").(string))
+	if err != nil {
+		return nil
+	}
+
+	earlyRenewalHours := d.Get("early_renewal_hours").(int)
+	if earlyRenewalHours > 0 && time.Until(notAfter) < time.Duration(earlyRenewalHours)*time.Hour {
+		log.Printf("[INFO] ACMPCA Locally Signed Certificate %q is within its early_renewal_hours window - forcing re-issuance", d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceAwsAcmpcaLocallySignedCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func acmpcaLocallySignedCertificateNotAfter(notBefore time.Time, unit string, length int) (time.Time, error) {
+	switch unit {
+	case "HOURS":
+		return notBefore.Add(time.Duration(length) * time.Hour), nil
+	case "DAYS":
+		return notBefore.AddDate(0, 0, length), nil
+	default:
+		return time.Time{}, fmt.Errorf("unsupported validity_unit %q", unit)
+	}
+}
+
+// acmpcaKeyUsages maps tls_locally_signed_cert's allowed_uses values that
+// populate the basic X.509 KeyUsage bitmask.
+var acmpcaKeyUsages = map[string]x509.KeyUsage{
+	"digital_signature":  x509.KeyUsageDigitalSignature,
+	"content_commitment": x509.KeyUsageContentCommitment,
+	"key_encipherment":   x509.KeyUsageKeyEncipherment,
+	"data_encipherment":  x509.KeyUsageDataEncipherment,
+	"key_agreement":      x509.KeyUsageKeyAgreement,
+	"cert_signing":       x509.KeyUsageCertSign,
+	"crl_signing":        x509.KeyUsageCRLSign,
+	"encipher_only":      x509.KeyUsageEncipherOnly,
+	"decipher_only":      x509.KeyUsageDecipherOnly,
+}
+
+// acmpcaExtKeyUsages maps tls_locally_signed_cert's allowed_uses values that
+// populate the X.509 extended key usage extension.
+var acmpcaExtKeyUsages = map[string]x509.ExtKeyUsage{
+	"any_extended":                      x509.ExtKeyUsageAny,
+	"server_auth":                       x509.ExtKeyUsageServerAuth,
+	"client_auth":                       x509.ExtKeyUsageClientAuth,
+	"code_signing":                      x509.ExtKeyUsageCodeSigning,
+	"email_protection":                  x509.ExtKeyUsageEmailProtection,
+	"ipsec_end_system":                  x509.ExtKeyUsageIPSECEndSystem,
+	"ipsec_tunnel":                      x509.ExtKeyUsageIPSECTunnel,
+	"ipsec_user":                        x509.ExtKeyUsageIPSECUser,
+	"timestamping":                      x509.ExtKeyUsageTimeStamping,
+	"ocsp_signing":                      x509.ExtKeyUsageOCSPSigning,
+	"microsoft_server_gated_crypto":     x509.ExtKeyUsageMicrosoftServerGatedCrypto,
+	"netscape_server_gated_crypto":      x509.ExtKeyUsageNetscapeServerGatedCrypto,
+	"microsoft_commercial_code_signing": x509.ExtKeyUsageMicrosoftCommercialCodeSigning,
+	"microsoft_kernel_code_signing":     x509.ExtKeyUsageMicrosoftKernelCodeSigning,
+}
+
+// acmpcaLocallySignedCertificateAllowedUses returns every allowed_uses value
+// this resource accepts, for use in its schema validation.
+func acmpcaLocallySignedCertificateAllowedUses() []string {
+	uses := make([]string, 0, len(acmpcaKeyUsages)+len(acmpcaExtKeyUsages))
+	for use := range acmpcaKeyUsages {
+		uses = append(uses, use)
+	}
+	for use := range acmpcaExtKeyUsages {
+		uses = append(uses, use)
+	}
+	return uses
+}
+
+// acmpcaSignatureAlgorithms maps tls_locally_signed_cert's signing_algorithm
+// values to the x509.SignatureAlgorithm CreateCertificate signs with.
+var acmpcaSignatureAlgorithms = map[string]x509.SignatureAlgorithm{
+	"SHA1WithRSA":     x509.SHA1WithRSA,
+	"SHA256WithRSA":   x509.SHA256WithRSA,
+	"SHA384WithRSA":   x509.SHA384WithRSA,
+	"SHA512WithRSA":   x509.SHA512WithRSA,
+	"ECDSAWithSHA1":   x509.ECDSAWithSHA1,
+	"ECDSAWithSHA256": x509.ECDSAWithSHA256,
+	"ECDSAWithSHA384": x509.ECDSAWithSHA384,
+	"ECDSAWithSHA512": x509.ECDSAWithSHA512,
+}
+
+// acmpcaLocallySignedCertificateSigningAlgorithms returns every
+// signing_algorithm value this resource accepts, for use in its schema
+// validation.
+func acmpcaLocallySignedCertificateSigningAlgorithms() []string {
+	algorithms := make([]string, 0, len(acmpcaSignatureAlgorithms))
+	for algorithm := range acmpcaSignatureAlgorithms {
+		algorithms = append(algorithms, algorithm)
+	}
+	return algorithms
+}
+
+func acmpcaExpandSignatureAlgorithm(signingAlgorithm string) (x509.SignatureAlgorithm, error) {
+	algorithm, ok := acmpcaSignatureAlgorithms[signingAlgorithm]
+	if !ok {
+		return x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported signing_algorithm %q", signingAlgorithm)
+	}
+	return algorithm, nil
+}
+
+func acmpcaExpandKeyUsage(l []interface{}) x509.KeyUsage {
+	var keyUsage x509.KeyUsage
+
+	for _, item := range l {
+		if usage, ok := acmpcaKeyUsages[item.(string)]; ok {
+			keyUsage |= usage
+		}
+	}
+
+	return keyUsage
+}
+
+func acmpcaExpandExtKeyUsages(l []interface{}) []x509.ExtKeyUsage {
+	usages := make([]x509.ExtKeyUsage, 0, len(l))
+
+	for _, item := range l {
+		if usage, ok := acmpcaExtKeyUsages[item.(string)]; ok {
+			usages = append(usages, usage)
+		}
+	}
+
+	return usages
+}
+
+func acmpcaExpandIPAddresses(l []interface{}) []net.IP {
+	ips := make([]net.IP, 0, len(l))
+
+	for _, item := range l {
+		if ip := net.ParseIP(item.(string)); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
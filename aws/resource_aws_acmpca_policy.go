@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
+)
+
+// resourceAwsAcmpcaPolicy manages a resource-based policy on an ACMPCA
+// Certificate Authority, analogous to the IAM resource policies attached to
+// KMS keys or SQS queues elsewhere in this provider.
+func resourceAwsAcmpcaPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaPolicyPut,
+		Read:   resourceAwsAcmpcaPolicyRead,
+		Update: resourceAwsAcmpcaPolicyPut,
+		Delete: resourceAwsAcmpcaPolicyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateIAMPolicyJson,
+				DiffSuppressFunc: suppressEquivalentAwsPolicyDiffs,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaPolicyPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	resourceArn := d.Get("resource_arn").(string)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy").(string))
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %s", d.Get("policy").(string), err)
+	}
+
+	input := &acmpca.PutPolicyInput{
+		Policy:      aws.String(policy),
+		ResourceArn: aws.String(resourceArn),
+	}
+
+	log.Printf("[DEBUG] Putting ACMPCA Policy: %s", input)
+	_, err = conn.PutPolicy(input)
+	if err != nil {
+		return fmt.Errorf("error putting ACMPCA Policy: %s", err)
+	}
+
+	d.SetId(resourceArn)
+
+	return resourceAwsAcmpcaPolicyRead(d, meta)
+}
+
+func resourceAwsAcmpcaPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetPolicyInput{
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Policy: %s", input)
+
+	output, err := conn.GetPolicy(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACMPCA Policy %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Policy: %s", err)
+	}
+
+	if output == nil || output.Policy == nil {
+		log.Printf("[WARN] ACMPCA Policy %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("resource_arn", d.Id())
+
+	policyToSet, err := structure.NormalizeJsonString(aws.StringValue(output.Policy))
+	if err != nil {
+		return fmt.Errorf("policy (%s) is invalid JSON: %s", aws.StringValue(output.Policy), err)
+	}
+	d.Set("policy", policyToSet)
+
+	return nil
+}
+
+func resourceAwsAcmpcaPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.DeletePolicyInput{
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting ACMPCA Policy: %s", input)
+	_, err := conn.DeletePolicy(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			return nil
+		}
+		return fmt.Errorf("error deleting ACMPCA Policy: %s", err)
+	}
+
+	return nil
+}
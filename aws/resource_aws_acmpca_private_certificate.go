@@ -0,0 +1,412 @@
+package aws
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceAwsAcmpcaPrivateCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsAcmpcaPrivateCertificateCreate,
+		Read:   resourceAwsAcmpcaPrivateCertificateRead,
+		Delete: resourceAwsAcmpcaPrivateCertificateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+			"certificate_chain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			// certificate_signing_request is required unless domain_name is
+			// set, in which case a CSR is generated internally from
+			// domain_name/subject_alternative_names/private_key_pem, mirroring
+			// what an ACME client would do before calling IssueCertificate.
+			"certificate_signing_request": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"domain_name"},
+			},
+			"domain_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"certificate_signing_request"},
+			},
+			"early_renewal_hours": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			// http_01_challenge requires domain_name, and asserts the
+			// corresponding aws_acmpca_acme_responder's challenge response is
+			// actually reachable at domain_name's
+			// .well-known/acme-challenge/<token> before a certificate is
+			// issued, so an ACME-style issuance can't silently skip domain
+			// validation.
+			"http_01_challenge": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"certificate_signing_request"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_authorization": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"token": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"issuer": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_after": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"not_before": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_key_pem": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"subject": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subject_alternative_names": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"revocation_reason": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  acmpca.RevocationReasonUnspecified,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.RevocationReasonUnspecified,
+					acmpca.RevocationReasonKeyCompromise,
+					acmpca.RevocationReasonCertificateAuthorityCompromise,
+					acmpca.RevocationReasonAffiliationChanged,
+					acmpca.RevocationReasonSuperseded,
+					acmpca.RevocationReasonCessationOfOperation,
+					acmpca.RevocationReasonPrivilegeWithdrawn,
+					acmpca.RevocationReasonAACompromise,
+				}, false),
+			},
+			"revoke_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"serial": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"signing_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.SigningAlgorithmSha256withecdsa,
+					acmpca.SigningAlgorithmSha256withrsa,
+					acmpca.SigningAlgorithmSha384withecdsa,
+					acmpca.SigningAlgorithmSha384withrsa,
+					acmpca.SigningAlgorithmSha512withecdsa,
+					acmpca.SigningAlgorithmSha512withrsa,
+				}, false),
+			},
+			"template_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "arn:aws:acm-pca:::template/EndEntityCertificate/V1",
+				ValidateFunc: validateArn,
+			},
+			"validity_length": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"validity_unit": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					acmpca.ValidityPeriodTypeAbsolute,
+					acmpca.ValidityPeriodTypeDays,
+					acmpca.ValidityPeriodTypeEndDate,
+					acmpca.ValidityPeriodTypeMonths,
+					acmpca.ValidityPeriodTypeYears,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsAcmpcaPrivateCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+	caARN := d.Get("certificate_authority_arn").(string)
+
+	csr := d.Get("certificate_signing_request").(string)
+	domainName, hasDomainName := d.GetOk("domain_name")
+	if csr == "" && !hasDomainName {
+		return fmt.Errorf("one of certificate_signing_request or domain_name must be set")
+	}
+	if hasDomainName {
+		generatedCsr, err := acmpcaGenerateCertificateSigningRequest(
+			domainName.(string),
+			expandStringList(d.Get("subject_alternative_names").([]interface{})),
+			d.Get("private_key_pem").(string),
+		)
+		if err != nil {
+			return fmt.Errorf("error generating certificate signing request for domain %q: %s", domainName, err)
+		}
+		csr = generatedCsr
+		d.Set("certificate_signing_request", csr)
+	}
+
+	if v, ok := d.GetOk("http_01_challenge"); ok {
+		if !hasDomainName {
+			return fmt.Errorf("http_01_challenge requires domain_name to be set")
+		}
+		challenge := v.([]interface{})[0].(map[string]interface{})
+		if err := acmpcaWaitForHttp01Challenge(domainName.(string), challenge["token"].(string), challenge["key_authorization"].(string)); err != nil {
+			return fmt.Errorf("error validating ACME HTTP-01 challenge for domain %q: %s", domainName, err)
+		}
+	}
+
+	input := &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(caARN),
+		Csr:                     []byte(csr),
+		IdempotencyToken:        aws.String(resource.UniqueId()),
+		SigningAlgorithm:        aws.String(d.Get("signing_algorithm").(string)),
+		TemplateArn:             aws.String(d.Get("template_arn").(string)),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(d.Get("validity_unit").(string)),
+			Value: aws.Int64(int64(d.Get("validity_length").(int))),
+		},
+	}
+
+	log.Printf("[DEBUG] Issuing ACMPCA Certificate: %s", input)
+	output, err := conn.IssueCertificate(input)
+	if err != nil {
+		return fmt.Errorf("error issuing ACMPCA Certificate: %s", err)
+	}
+
+	certificateArn := aws.StringValue(output.CertificateArn)
+
+	getCertificateInput := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(certificateArn),
+		CertificateAuthorityArn: aws.String(caARN),
+	}
+
+	log.Printf("[DEBUG] Waiting for ACMPCA Certificate %q to be issued", certificateArn)
+	if err := conn.WaitUntilCertificateIssued(getCertificateInput); err != nil {
+		return fmt.Errorf("error waiting for ACMPCA Certificate %q to be issued: %s", certificateArn, err)
+	}
+
+	d.SetId(certificateArn)
+
+	return resourceAwsAcmpcaPrivateCertificateRead(d, meta)
+}
+
+func resourceAwsAcmpcaPrivateCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(d.Id()),
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+	}
+
+	log.Printf("[DEBUG] Reading ACMPCA Certificate: %s", input)
+
+	output, err := conn.GetCertificate(input)
+	if err != nil {
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") {
+			log.Printf("[WARN] ACMPCA Certificate %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading ACMPCA Certificate: %s", err)
+	}
+
+	d.Set("arn", d.Id())
+	d.Set("certificate", output.Certificate)
+	d.Set("certificate_chain", output.CertificateChain)
+	d.Set("serial", acmpcaCertificateSerialFromArn(d.Id()))
+
+	if block, _ := pem.Decode([]byte(aws.StringValue(output.Certificate))); block != nil {
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			d.Set("issuer", cert.Issuer.String())
+			d.Set("subject", cert.Subject.String())
+			d.Set("not_before", cert.NotBefore.Format(time.RFC3339))
+			d.Set("not_after", cert.NotAfter.Format(time.RFC3339))
+
+			earlyRenewalHours := d.Get("early_renewal_hours").(int)
+			if earlyRenewalHours > 0 && time.Until(cert.NotAfter) < time.Duration(earlyRenewalHours)*time.Hour {
+				log.Printf("[INFO] ACMPCA Private Certificate %q is within its early_renewal_hours window - forcing re-issuance", d.Id())
+				d.SetId("")
+			}
+		}
+	}
+
+	return nil
+}
+
+// acmpcaGenerateCertificateSigningRequest builds a PEM-encoded CSR for
+// domainName (+ any additional subject_alternative_names) signed by
+// privateKeyPem, mirroring what the tls provider's tls_cert_request resource
+// does so users don't have to run openssl out-of-band before requesting a
+// certificate from their private CA.
+func acmpcaGenerateCertificateSigningRequest(domainName string, subjectAlternativeNames []string, privateKeyPem string) (string, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return "", fmt.Errorf("could not decode PEM block from private_key_pem")
+	}
+
+	signer, err := parsePrivateKeyPemBlock(block)
+	if err != nil {
+		return "", err
+	}
+
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: domainName,
+		},
+		DNSNames: append([]string{domainName}, subjectAlternativeNames...),
+	}
+
+	csrDer, err := x509.CreateCertificateRequest(rand.Reader, template, signer)
+	if err != nil {
+		return "", fmt.Errorf("error creating certificate signing request: %s", err)
+	}
+
+	csrPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDer})
+
+	return string(csrPem), nil
+}
+
+func parsePrivateKeyPemBlock(block *pem.Block) (interface{}, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private_key_pem: %s", err)
+	}
+	return key, nil
+}
+
+// acmpcaWaitForHttp01Challenge polls http://domainName/<acmpcaAcmeChallengeS3Key>
+// until it serves keyAuthorization, confirming the domain is actually
+// pointed at the aws_acmpca_acme_responder staging the challenge response
+// before a certificate for that domain is issued.
+func acmpcaWaitForHttp01Challenge(domainName, token, keyAuthorization string) error {
+	url := fmt.Sprintf("http://%s/%s", domainName, acmpcaAcmeChallengeS3Key(token))
+
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		resp, err := http.Get(url)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return resource.RetryableError(fmt.Errorf("challenge response at %q returned status %d", url, resp.StatusCode))
+		}
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+
+		if strings.TrimSpace(string(body)) != keyAuthorization {
+			return resource.RetryableError(fmt.Errorf("challenge response at %q does not match expected key authorization", url))
+		}
+
+		return nil
+	})
+}
+
+// acmpcaCertificateSerialFromArn extracts the certificate serial number from
+// an ACMPCA certificate ARN of the form
+// arn:aws:acm-pca:region:account:certificate-authority/CA-ID/certificate/SERIAL
+func acmpcaCertificateSerialFromArn(certificateArn string) string {
+	parts := strings.Split(certificateArn, "/")
+	return parts[len(parts)-1]
+}
+
+func resourceAwsAcmpcaPrivateCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	if !d.Get("revoke_on_destroy").(bool) {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).acmpcaconn
+
+	input := &acmpca.RevokeCertificateInput{
+		CertificateAuthorityArn: aws.String(d.Get("certificate_authority_arn").(string)),
+		CertificateSerial:       aws.String(acmpcaCertificateSerialFromArn(d.Id())),
+		RevocationReason:        aws.String(d.Get("revocation_reason").(string)),
+	}
+
+	log.Printf("[DEBUG] Revoking ACMPCA Certificate: %s", input)
+	_, err := conn.RevokeCertificate(input)
+	if err != nil {
+		// ResourceNotFoundException: the CA is already gone.
+		// RequestAlreadyProcessedException: the certificate was already revoked.
+		if isAWSErr(err, acmpca.ErrCodeResourceNotFoundException, "") || isAWSErr(err, acmpca.ErrCodeRequestAlreadyProcessedException, "") {
+			return nil
+		}
+		return fmt.Errorf("error revoking ACMPCA Certificate: %s", err)
+	}
+
+	return nil
+}
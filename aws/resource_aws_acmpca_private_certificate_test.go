@@ -31,15 +31,89 @@ func TestAccAwsAcmpcaPrivateCertificate_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "validity_unit", "YEARS"),
 					resource.TestCheckResourceAttr(resourceName, "signing_algorithm", "SHA256WITHRSA"),
 					resource.TestCheckResourceAttr(resourceName, "template_arn", "arn:aws:acm-pca:::template/EndEntityCertificate/V1"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer"),
+					resource.TestCheckResourceAttr(resourceName, "subject", "CN=testing"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
 				),
 			},
 		},
 	})
 }
 
+// TestAccAwsAcmpcaPrivateCertificate_AcmeStyle issues an ACME-style
+// certificate whose domain_name actually resolves to a host serving the
+// aws_acmpca_acme_responder's staged HTTP-01 challenge response, exercising
+// the real domain validation the two resources perform together before
+// IssueCertificate is called.
+func TestAccAwsAcmpcaPrivateCertificate_AcmeStyle(t *testing.T) {
+	resourceName := "aws_acmpca_private_certificate.test"
+	responderResourceName := "aws_acmpca_acme_responder.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsAcmpcaPrivateCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaPrivateCertificateConfig_AcmeStyle,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(responderResourceName, "id"),
+					testAccCheckAwsAcmpcaPrivateCertificateExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_chain"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate_signing_request"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_before"),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name", "acme.terraformtesting.com"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "subject_alternative_names.0", "www.acme.terraformtesting.com"),
+					resource.TestCheckResourceAttr(resourceName, "http_01_challenge.0.token", "acme-style-test-token"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccAwsAcmpcaPrivateCertificate_RevokeOnDestroy issues a certificate
+// with revoke_on_destroy set against a CA whose revocation_configuration
+// publishes a CRL to S3, then destroys the certificate and checks that the
+// CRL reflects the revocation - the gap testAccCheckAwsAcmpcaPrivateCertificateDestroy's
+// comment calls out, now that revoke_on_destroy is tracked in state.
+func TestAccAwsAcmpcaPrivateCertificate_RevokeOnDestroy(t *testing.T) {
+	resourceName := "aws_acmpca_private_certificate.test"
+	bucketResourceName := "aws_s3_bucket.crl"
+	var serial string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProvidersWithTLS,
+		CheckDestroy: testAccCheckAwsAcmpcaPrivateCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAcmpcaPrivateCertificateConfig_RevokeOnDestroy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAcmpcaPrivateCertificateExists(resourceName),
+					testAccCheckAwsAcmpcaCertificateSerial(resourceName, &serial),
+					resource.TestCheckResourceAttr(resourceName, "revoke_on_destroy", "true"),
+				),
+			},
+			{
+				// Removing the certificate revokes it; the CRL is checked
+				// here, while the CRL bucket still exists.
+				Config: testAccAwsAcmpcaPrivateCertificateConfig_RevokeOnDestroy_Removed,
+				Check:  testAccCheckAwsAcmpcaCertificateRevokedInCrl(bucketResourceName, &serial),
+			},
+		},
+	})
+}
+
 func testAccCheckAwsAcmpcaPrivateCertificateDestroy(s *terraform.State) error {
 	// unfortunately aws pca does not have an API to determine if a cert has been revoked.
 	// see: https://docs.aws.amazon.com/acm-pca/latest/userguide/PcaRevokeCert.html
+	// When revoke_on_destroy is set, TestAccAwsAcmpcaPrivateCertificate_RevokeOnDestroy
+	// checks the published CRL directly instead of relying on this CheckDestroy.
 	return nil
 }
 
@@ -106,3 +180,183 @@ resource "aws_acmpca_private_certificate" "test" {
 	validity_unit = "YEARS"
 }
 `
+
+// testAccAwsAcmpcaPrivateCertificateConfig_AcmeStyle stages a challenge
+// response via aws_acmpca_acme_responder and has the
+// aws_acmpca_private_certificate reference the very same token/key
+// authorization, so Create's HTTP-01 validation is checking the responder
+// this config actually provisioned. domain_name is expected to already
+// resolve (via out-of-band DNS in the test account) to a host serving
+// aws_s3_bucket.challenge as a static website - acquiring that DNS record
+// itself is outside the scope of the ACM-PCA resources in this provider.
+const testAccAwsAcmpcaPrivateCertificateConfig_AcmeStyle = `
+resource "tls_private_key" "key" {
+  algorithm = "RSA"
+}
+
+resource "aws_s3_bucket" "challenge" {
+  bucket        = "tf-acc-test-acmpca-private-cert-acme-challenge"
+  force_destroy = true
+}
+
+resource "aws_acmpca_acme_responder" "test" {
+  http_01_challenge {
+    s3_bucket         = aws_s3_bucket.challenge.id
+    token             = "acme-style-test-token"
+    key_authorization = "acme-style-test-token.acme-style-test-key-thumbprint"
+  }
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+}
+
+resource "aws_acmpca_private_certificate" "test" {
+  certificate_authority_arn = aws_acmpca_certificate_authority.test.arn
+  domain_name               = "acme.terraformtesting.com"
+  subject_alternative_names = ["www.acme.terraformtesting.com"]
+  private_key_pem           = tls_private_key.key.private_key_pem
+  signing_algorithm         = "SHA256WITHRSA"
+  validity_length           = 1
+  validity_unit             = "YEARS"
+
+  http_01_challenge {
+    token             = aws_acmpca_acme_responder.test.http_01_challenge[0].token
+    key_authorization = aws_acmpca_acme_responder.test.http_01_challenge[0].key_authorization
+  }
+}
+`
+
+const testAccAwsAcmpcaPrivateCertificateConfig_RevokeOnDestroy = `
+resource "tls_private_key" "key" {
+  algorithm = "RSA"
+}
+
+resource "tls_cert_request" "csr" {
+  key_algorithm   = "RSA"
+  private_key_pem = tls_private_key.key.private_key_pem
+
+  subject {
+    common_name = "testing"
+  }
+}
+
+resource "aws_s3_bucket" "crl" {
+  bucket        = "tf-acc-test-acmpca-private-cert-crl"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "crl" {
+  bucket = aws_s3_bucket.crl.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AcmPcaWriteCrl"
+      Effect    = "Allow"
+      Principal = { Service = "acm-pca.amazonaws.com" }
+      Action    = ["s3:GetBucketAcl", "s3:PutObject"]
+      Resource = [
+        aws_s3_bucket.crl.arn,
+        "${aws_s3_bucket.crl.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled            = true
+      expiration_in_days = 7
+      s3_bucket_name     = aws_s3_bucket.crl.id
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.crl]
+}
+
+resource "aws_acmpca_private_certificate" "test" {
+  certificate_authority_arn   = aws_acmpca_certificate_authority.test.arn
+  certificate_signing_request = tls_cert_request.csr.cert_request_pem
+  signing_algorithm           = "SHA256WITHRSA"
+  validity_length             = 1
+  validity_unit               = "YEARS"
+  revoke_on_destroy           = true
+}
+`
+
+const testAccAwsAcmpcaPrivateCertificateConfig_RevokeOnDestroy_Removed = `
+resource "aws_s3_bucket" "crl" {
+  bucket        = "tf-acc-test-acmpca-private-cert-crl"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "crl" {
+  bucket = aws_s3_bucket.crl.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AcmPcaWriteCrl"
+      Effect    = "Allow"
+      Principal = { Service = "acm-pca.amazonaws.com" }
+      Action    = ["s3:GetBucketAcl", "s3:PutObject"]
+      Resource = [
+        aws_s3_bucket.crl.arn,
+        "${aws_s3_bucket.crl.arn}/*",
+      ]
+    }]
+  })
+}
+
+resource "aws_acmpca_certificate_authority" "test" {
+  permanent_deletion_time_in_days = 7
+  type                            = "ROOT"
+  validity_length                 = 1
+  validity_unit                   = "YEARS"
+
+  certificate_authority_configuration {
+    key_algorithm     = "RSA_4096"
+    signing_algorithm = "SHA512WITHRSA"
+
+    subject {
+      common_name = "terraformtesting.com"
+    }
+  }
+
+  revocation_configuration {
+    crl_configuration {
+      enabled            = true
+      expiration_in_days = 7
+      s3_bucket_name     = aws_s3_bucket.crl.id
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.crl]
+}
+`